@@ -0,0 +1,137 @@
+package glicko2
+
+import "math"
+
+// matchResult pairs an opponent snapshot with the score (1 win, 0 loss, 0.5
+// draw) and the per-game advantage flag recorded via AddResult.  UpdateBoost
+// merges this with the plain wins/losses/draws recorded via AddWin/AddLoss/
+// AddDraw (which carry no advantage) to build its result set.
+type matchResult struct {
+	opponent  *Glicko2
+	score     float64
+	advantage bool
+}
+
+// BoostConfig holds the Glicko-Boost extension parameters described in
+// Glickman's FIDE/Deloitte chess rating challenge entry: an advantage
+// parameter eta added to the player's rating for games flagged as played
+// with an advantage (e.g. playing White); a boost threshold k and factors
+// B1/B2 that inflate a player's post-period RD when their performance
+// exceeds expectation by more than k standard deviations; and five decay
+// parameters controlling RD growth during idle periods. Unlike the rest of
+// the public API, these work on the internal Glicko-2 scale: Eta is added to
+// mu (see Mu), and the decay polynomial is added to phi squared (see Phi).
+// A zero-value
+// BoostConfig makes UpdateBoost produce the same result as Update.
+type BoostConfig struct {
+	Eta                                    float64
+	B1, B2, K                              float64
+	Alpha0, Alpha1, Alpha2, Alpha3, Alpha4 float64
+}
+
+// Add a generic result (score 1.0 for a win, 0.0 for a loss, 0.5 for a draw)
+// to this rating, optionally flagging that the game was played with the
+// eta advantage.  Only consumed by UpdateBoost; Update() ignores it.  Note
+// that no calculation is performed until UpdateBoost() is called.
+func (g *Glicko2) AddResult(opponent *Glicko2, score float64, advantage bool) {
+	g.extras = append(g.extras, matchResult{opponent.duplicate(), score, advantage})
+}
+
+// gather the unified result set UpdateBoost operates over: the plain
+// wins/losses/draws (advantage always false) plus anything recorded via
+// AddResult.
+func (g *Glicko2) boostResults() []matchResult {
+	all := make([]matchResult, 0, len(g.wins)+len(g.losses)+len(g.draws)+len(g.extras))
+	for _, r := range g.wins {
+		all = append(all, matchResult{r, 1.0, false})
+	}
+	for _, r := range g.losses {
+		all = append(all, matchResult{r, 0.0, false})
+	}
+	for _, r := range g.draws {
+		all = append(all, matchResult{r, 0.5, false})
+	}
+	all = append(all, g.extras...)
+	return all
+}
+
+// UpdateBoost updates the rating using Glickman's Glicko-Boost extension:
+// it applies the eta advantage to E and delta for games flagged via
+// AddResult, inflates the resulting RD when observed performance beats
+// expectation by more than cfg.K standard deviations, and grows RD during
+// idle periods with a polynomial in periods-idle instead of the plain
+// sqrt(RD^2+sigma^2) used by Update.  Tau and the Step 5 convergence
+// tolerance still come from the instance's Config (see NewWithConfig).
+// A zero-value cfg reduces this to the same result as Update.
+func (g *Glicko2) UpdateBoost(cfg *BoostConfig) {
+
+	results := g.boostResults()
+
+	// No games played: grow RD per the idle-decay polynomial instead of
+	// the plain sqrt(RD^2+sigma^2) step, and track how long we've been idle.
+	if len(results) == 0 {
+		t := float64(g.idle + 1)
+		growth := cfg.Alpha0 + cfg.Alpha1*t + cfg.Alpha2*t*t + cfg.Alpha3*t*t*t + cfg.Alpha4*t*t*t*t
+		g.deviation = math.Sqrt((g.deviation * g.deviation) + (g.volatility * g.volatility) + growth)
+		g.idle++
+		return
+	}
+	g.idle = 0
+
+	effectiveRating := func(advantage bool) float64 {
+		if advantage {
+			return g.rating + cfg.Eta
+		}
+		return g.rating
+	}
+
+	// Step 3/4, with eta folded into the player's rating for advantaged
+	// games, and tracking the raw performance variance (pre-inversion) so
+	// we can turn it into a standard deviation for the boost z-score below.
+	perfVariance := 0.0
+	variance := 0.0
+	delta := 0.0
+	observed := 0.0
+	expected := 0.0
+	for _, r := range results {
+		g_i := calcG(r.opponent.deviation)
+		e_i := calcE(effectiveRating(r.advantage), r.opponent.rating, r.opponent.deviation)
+		perfVariance += (g_i * g_i) * e_i * (1.0 - e_i)
+		delta += g_i * (r.score - e_i)
+		observed += r.score
+		expected += e_i
+	}
+	variance = 1.0 / perfVariance
+	delta *= variance
+
+	// Step 5, identical to Update().
+	new_volatility := solveVolatility(g.cfg(), g.deviation, g.volatility, variance, delta)
+
+	// Step 6/7, identical to Update().
+	pre_deviation := math.Sqrt((g.deviation * g.deviation) + (new_volatility * new_volatility))
+	new_deviation := 1.0 / (math.Sqrt(1.0/(pre_deviation*pre_deviation) + 1.0/variance))
+	new_rating := 0.0
+	for _, r := range results {
+		g_i := calcG(r.opponent.deviation)
+		e_i := calcE(effectiveRating(r.advantage), r.opponent.rating, r.opponent.deviation)
+		new_rating += g_i * (r.score - e_i)
+	}
+	new_rating = new_rating*(new_deviation*new_deviation) + g.rating
+
+	// Boost step: inflate RD when observed performance exceeds expectation
+	// by more than cfg.K standard deviations of performance.
+	z := (observed - expected) / math.Sqrt(perfVariance)
+	if z > cfg.K {
+		factor := 1.0 + cfg.B1*(z-cfg.K)
+		if cfg.B2 > 0 && factor > cfg.B2 {
+			factor = cfg.B2
+		}
+		new_deviation *= factor
+	}
+
+	g.deviation = new_deviation
+	g.volatility = new_volatility
+	g.rating = new_rating
+
+	g.ClearResults()
+}
@@ -0,0 +1,69 @@
+package glicko2
+
+import (
+	"testing"
+)
+
+func TestUpdateBoostReducesToUpdate(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+	b := NewGlicko2(1400.0, 30.0, 0.06)
+	c := NewGlicko2(1550.0, 100.0, 0.06)
+	d := NewGlicko2(1700.0, 300.0, 0.06)
+
+	a.AddWin(b)
+	a.AddLoss(c)
+	a.AddLoss(d)
+	a.Update()
+
+	e := NewGlicko2(1500.0, 200.0, 0.06)
+	f := NewGlicko2(1400.0, 30.0, 0.06)
+	g := NewGlicko2(1550.0, 100.0, 0.06)
+	h := NewGlicko2(1700.0, 300.0, 0.06)
+
+	e.AddWin(f)
+	e.AddLoss(g)
+	e.AddLoss(h)
+	e.UpdateBoost(&BoostConfig{})
+
+	if !inRange(a.Rating(), e.Rating(), 0.0001) {
+		t.Error()
+	}
+	if !inRange(a.Deviation(), e.Deviation(), 0.0001) {
+		t.Error()
+	}
+	if !inRange(a.Volatility(), e.Volatility(), 0.0001) {
+		t.Error()
+	}
+}
+
+func TestUpdateBoostInflatesRDOnOverperformance(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+	b := NewGlicko2(1400.0, 30.0, 0.06)
+	c := NewGlicko2(1400.0, 30.0, 0.06)
+
+	a.AddWin(b)
+	a.AddWin(c)
+
+	a.UpdateBoost(&BoostConfig{B1: 1.0, B2: 10.0, K: 0.0})
+
+	baseline := NewGlicko2(1500.0, 200.0, 0.06)
+	b2 := NewGlicko2(1400.0, 30.0, 0.06)
+	c2 := NewGlicko2(1400.0, 30.0, 0.06)
+	baseline.AddWin(b2)
+	baseline.AddWin(c2)
+	baseline.Update()
+
+	if a.Deviation() <= baseline.Deviation() {
+		t.Error()
+	}
+}
+
+func TestUpdateBoostIdleDecay(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+
+	a.UpdateBoost(&BoostConfig{Alpha0: 25.0})
+
+	if a.Deviation() <= 200.0 {
+		t.Error()
+	}
+}
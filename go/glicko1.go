@@ -0,0 +1,81 @@
+package glicko2
+
+import "math"
+
+// qConstant is ln(10)/400, the scale constant used throughout the original
+// Glicko algorithm (as opposed to Glicko-2's mu/phi scale).
+const qConstant = math.Ln10 / 400.0
+
+// calcG1 is the original Glicko system's 'g' function, analogous to calcG
+// but operating directly on Glicko-scale (not Glicko-2-scale) deviations.
+func calcG1(deviation float64) float64 {
+	return 1.0 / math.Sqrt(1.0+3.0*qConstant*qConstant*(deviation*deviation)/(math.Pi*math.Pi))
+}
+
+// calcE1 is the original Glicko system's 'E' function: the expected score
+// for a player of the given rating against an opponent of rating_opponent
+// and deviation_opponent, both on the Glicko scale.
+func calcE1(rating float64, rating_opponent float64, deviation_opponent float64) float64 {
+	return 1.0 / (1.0 + math.Pow(10.0, -calcG1(deviation_opponent)*(rating-rating_opponent)/400.0))
+}
+
+// UpdateGlicko1 updates the rating using the original (pre-Glicko-2) Glicko
+// algorithm: a closed-form computation with no volatility term and no
+// Newton-Raphson/Illinois solver, which some games (e.g. Pokemon Showdown,
+// Quake Live) prefer for its more predictable convergence on small sample
+// sizes. c is the system constant controlling how fast RD grows per idle
+// rating period; idlePeriods is the number of rating periods since this
+// player's last Update/UpdateGlicko1/UpdateBoost call.  Unlike Update(),
+// this never touches volatility.
+func (g *Glicko2) UpdateGlicko1(c float64, idlePeriods int) {
+
+	rating := g.Rating()
+	deviation := g.Deviation()
+
+	// RD grows with time since the player's last rating period, capped at
+	// this instance's configured default deviation.
+	t := float64(idlePeriods)
+	deviation = math.Min(math.Sqrt((deviation*deviation)+(c*c*t)), g.cfg().DefaultDeviation)
+
+	if len(g.wins)+len(g.losses)+len(g.draws) == 0 {
+		g.SetDeviation(deviation)
+		g.ClearResults()
+		return
+	}
+
+	dSquaredInv := 0.0
+	for _, r := range g.wins {
+		g_i := calcG1(r.Deviation())
+		e_i := calcE1(rating, r.Rating(), r.Deviation())
+		dSquaredInv += (g_i * g_i) * e_i * (1.0 - e_i)
+	}
+	for _, r := range g.losses {
+		g_i := calcG1(r.Deviation())
+		e_i := calcE1(rating, r.Rating(), r.Deviation())
+		dSquaredInv += (g_i * g_i) * e_i * (1.0 - e_i)
+	}
+	for _, r := range g.draws {
+		g_i := calcG1(r.Deviation())
+		e_i := calcE1(rating, r.Rating(), r.Deviation())
+		dSquaredInv += (g_i * g_i) * e_i * (1.0 - e_i)
+	}
+	dSquaredInv *= qConstant * qConstant
+
+	newDeviation := math.Sqrt(1.0 / (1.0/(deviation*deviation) + dSquaredInv))
+
+	sum := 0.0
+	for _, r := range g.wins {
+		sum += calcG1(r.Deviation()) * (1.0 - calcE1(rating, r.Rating(), r.Deviation()))
+	}
+	for _, r := range g.losses {
+		sum += calcG1(r.Deviation()) * (0.0 - calcE1(rating, r.Rating(), r.Deviation()))
+	}
+	for _, r := range g.draws {
+		sum += calcG1(r.Deviation()) * (0.5 - calcE1(rating, r.Rating(), r.Deviation()))
+	}
+	newRating := rating + qConstant*(newDeviation*newDeviation)*sum
+
+	g.SetRating(newRating)
+	g.SetDeviation(newDeviation)
+	g.ClearResults()
+}
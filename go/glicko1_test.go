@@ -0,0 +1,36 @@
+package glicko2
+
+import (
+	"testing"
+)
+
+func TestUpdateGlicko1(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+	b := NewGlicko2(1400.0, 30.0, 0.06)
+	c := NewGlicko2(1550.0, 100.0, 0.06)
+	d := NewGlicko2(1700.0, 300.0, 0.06)
+
+	a.AddWin(b)
+	a.AddLoss(c)
+	a.AddLoss(d)
+
+	a.UpdateGlicko1(0, 0)
+
+	if !inRange(a.Rating(), 1464.06, 0.1) {
+		t.Error()
+	}
+	if !inRange(a.Deviation(), 151.4, 0.1) {
+		t.Error()
+	}
+}
+
+func TestUpdateGlicko1Idle(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+
+	a.UpdateGlicko1(30.0, 2)
+
+	// no games played: RD should grow, not shrink or stay put
+	if a.Deviation() <= 200.0 {
+		t.Error()
+	}
+}
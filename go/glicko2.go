@@ -1,26 +1,22 @@
-//
-//
 // Copyright (c) 2013 Stephen Waits
-// 
+//
 // This software is provided 'as-is', without any express or implied warranty. In
 // no event will the authors be held liable for any damages arising from the use
 // of this software.
-// 
+//
 // Permission is granted to anyone to use this software for any purpose,
 // including commercial applications, and to alter it and redistribute it freely,
 // subject to the following restrictions:
-// 
-// 1. The origin of this software must not be misrepresented; you must not claim
-//    that you wrote the original software. If you use this software in a
-//    product, an acknowledgment in the product documentation would be
-//    appreciated but is not required.
-// 
-// 2. Altered source versions must be plainly marked as such, and must not be
-//    misrepresented as being the original software.
-// 
-// 3. This notice may not be removed or altered from any source distribution.
 //
+//  1. The origin of this software must not be misrepresented; you must not claim
+//     that you wrote the original software. If you use this software in a
+//     product, an acknowledgment in the product documentation would be
+//     appreciated but is not required.
+//
+//  2. Altered source versions must be plainly marked as such, and must not be
+//     misrepresented as being the original software.
 //
+// 3. This notice may not be removed or altered from any source distribution.
 //
 // Glicko-2 Rating calculator package.
 //
@@ -32,7 +28,6 @@
 // improvement on the ELO system.
 //
 // The Glicko-2 system is specified on http://www.glicko.com/
-//
 package glicko2
 
 import (
@@ -43,29 +38,67 @@ import (
 // system constant, determines delta volatility over time; should be [0.3,1.2]
 const kDVOL float64 = 0.3
 
+// Config holds the tunable system parameters for a Glicko2 instance: the tau
+// constant that constrains volatility change over time, the convergence
+// tolerance used by the Step 5 iterative solver, and the default rating,
+// deviation, and volatility handed out by NewWithConfig.
+type Config struct {
+	Tau                  float64
+	ConvergenceTolerance float64
+	DefaultRating        float64
+	DefaultDeviation     float64
+	DefaultVolatility    float64
+}
+
+// DefaultConfig returns the Config reproducing this package's historical
+// behavior: Tau of 0.3, a convergence tolerance of 1e-7, and the classic
+// 1500/350/0.06 defaults.
+func DefaultConfig() *Config {
+	return &Config{
+		Tau:                  kDVOL,
+		ConvergenceTolerance: 0.0000001,
+		DefaultRating:        1500.0,
+		DefaultDeviation:     350.0,
+		DefaultVolatility:    0.06,
+	}
+}
+
 // Glicko2 state reprensentation (Glicko2 uses different scales from Glicko)
 type Glicko2 struct {
 	rating     float64
 	deviation  float64
 	volatility float64
+	config     *Config
 	wins       [](*Glicko2)
 	losses     [](*Glicko2)
 	draws      [](*Glicko2)
+	extras     []matchResult
+	idle       int
 }
 
 // Initializer with rating, rating deviation, and volatility specified.
 func NewGlicko2(rating float64, deviation float64, volatility float64) *Glicko2 {
-	return newGlicko2(rating, deviation, volatility)
+	return newGlicko2(rating, deviation, volatility, DefaultConfig())
 }
 
 // Initializer with default rating, rating deviation, and volatility.
 func NewDefaultGlicko2() *Glicko2 {
-	return newGlicko2(1500.0, 350.0, 0.06)
+	cfg := DefaultConfig()
+	return newGlicko2(cfg.DefaultRating, cfg.DefaultDeviation, cfg.DefaultVolatility, cfg)
+}
+
+// Initializer using a custom Config, with rating, deviation, and volatility
+// set to that Config's defaults.  Use this when tau or the convergence
+// tolerance need to be tuned away from DefaultConfig(), e.g. a low tau for a
+// low-variance game like chess or a high tau for a volatile one like a MOBA.
+func NewWithConfig(cfg *Config) *Glicko2 {
+	return newGlicko2(cfg.DefaultRating, cfg.DefaultDeviation, cfg.DefaultVolatility, cfg)
 }
 
 // helper for initialization
-func newGlicko2(rating float64, deviation float64, volatility float64) *Glicko2 {
+func newGlicko2(rating float64, deviation float64, volatility float64, cfg *Config) *Glicko2 {
 	g := new(Glicko2)
+	g.config = cfg
 	g.SetRating(rating)
 	g.SetDeviation(deviation)
 	g.SetVolatility(volatility)
@@ -117,6 +150,17 @@ func (g *Glicko2) duplicate() *Glicko2 {
 	return &c
 }
 
+// cfg returns this instance's Config, lazily defaulting it to
+// DefaultConfig() for a zero-value Glicko2 that was never built via
+// New*/Load (e.g. embedded in a struct or created with make([]Glicko2, n)),
+// so such callers keep getting the package's historical behavior.
+func (g *Glicko2) cfg() *Config {
+	if g.config == nil {
+		g.config = DefaultConfig()
+	}
+	return g.config
+}
+
 // Clear all results previously added via add_result(), add_win(), add_loss(),
 // and/or add_draw().  This method is called automatically whenever update()
 // is called.
@@ -124,6 +168,7 @@ func (g *Glicko2) ClearResults() {
 	g.wins = nil
 	g.losses = nil
 	g.draws = nil
+	g.extras = nil
 }
 
 // Add a win result to this rating.  Note that no calculation is performed until
@@ -167,36 +212,76 @@ func calcE(rating float64, rating_opponent float64, deviation_opponent float64)
 	return 1.0 / (1.0 + math.Exp(-calcG(deviation_opponent)*(rating-rating_opponent)))
 }
 
-// Update rating based on current results list, and clear results.
-func (g *Glicko2) Update() {
+// periodResult is a pre-period (rating, deviation) snapshot of an opponent
+// paired with the score (1 win, 0 loss, 0.5 draw) earned against them.
+// computeUpdate operates purely on these, independent of any *Glicko2, so
+// that RatingPeriod can run the same math against a snapshot taken once per
+// period instead of a duplicate()'d opponent per recorded result.
+type periodResult struct {
+	rating    float64
+	deviation float64
+	score     float64
+}
 
-	// merge wins, losses, draws slices for convenience
-	results := append(append(g.wins, g.losses...), g.draws...)
+// solveVolatility determines the new volatility (Step 5 of the Glicko-2
+// algorithm) using the Illinois variant of regula falsi prescribed by
+// Glickman's paper, rather than Newton-Raphson: it brackets the root
+// [A, B] of f(x) = (e^x(delta^2 - deviation^2 - variance - e^x))/(2(deviation^2 +
+// variance + e^x)^2) - (x - a)/tau^2 and narrows the bracket until it's
+// within cfg.ConvergenceTolerance, halving the retained endpoint A's f-value
+// each time the new point replaces B without a sign change (the "Illinois" half-step) so the
+// method can't stagnate the way plain regula falsi can. Unlike
+// Newton-Raphson, this is guaranteed to converge because the root stays
+// bracketed throughout.
+func solveVolatility(cfg *Config, deviation float64, volatility float64, variance float64, delta float64) float64 {
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		return (ex*(delta*delta-deviation*deviation-variance-ex))/(2.0*(deviation*deviation+variance+ex)*(deviation*deviation+variance+ex)) - (x-math.Log(volatility*volatility))/(cfg.Tau*cfg.Tau)
+	}
 
-	// Note that if a player does not compete during the rating period, then
-	// only Step 6 applies.
-	if len(results) == 0 {
-		g.deviation = math.Sqrt((g.deviation * g.deviation) + (g.volatility * g.volatility))
-		return
+	a := math.Log(volatility * volatility)
+	A := a
+	var B float64
+	if delta*delta > deviation*deviation+variance {
+		B = math.Log(delta*delta - deviation*deviation - variance)
+	} else {
+		k := 1.0
+		for f(a-k*cfg.Tau) < 0 {
+			k++
+		}
+		B = a - k*cfg.Tau
+	}
+
+	fA := f(A)
+	fB := f(B)
+	for math.Abs(B-A) > cfg.ConvergenceTolerance {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB < 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2.0
+		}
+		B, fB = C, fC
 	}
 
-	// Step 1. Determine a rating and RD for each player at the onset of the
-	// rating period. The system constant which constrains the change in
-	// volatility over time, needs to be set prior to application of the system.
-	// Reasonable choices are between 0.3 and 1.2, though the system should be
-	// tested to decide which value results in greatest predictive accuracy
-	// ... (ratings already stored in instance)
+	return math.Exp(A / 2.0)
+}
 
-	// Step 2. For each player, convert the ratings and RD's onto the Glicko-2
-	// scale.
-	// ... (ratings already stored in G2 format)
+// computeUpdate runs steps 3-7 of the Glicko-2 algorithm: given a player's
+// pre-period (rating, deviation, volatility) on the Glicko-2 scale and the
+// results earned against the opponents in results, it returns the player's
+// post-period (rating, deviation, volatility), still on the Glicko-2 scale.
+// Callers with no results for the period should skip this and apply the
+// Step 6 RD-only growth directly; computeUpdate assumes len(results) > 0.
+func computeUpdate(cfg *Config, rating float64, deviation float64, volatility float64, results []periodResult) (float64, float64, float64) {
 
 	// Step 3.  Compute the quantity v. This is the estimated variance of the
 	// team's/player's rating based only on game outcomes.
 	variance := 0.0
 	for _, r := range results {
 		g_i := calcG(r.deviation)
-		e_i := calcE(g.rating, r.rating, r.deviation)
+		e_i := calcE(rating, r.rating, r.deviation)
 		variance += (g_i * g_i) * e_i * (1.0 - e_i)
 	}
 	variance = 1.0 / variance
@@ -205,53 +290,50 @@ func (g *Glicko2) Update() {
 	// by comparing the pre-period rating to the performance rating based only
 	// on game outcomes.
 	delta := 0.0
-	for _, r := range g.wins {
-		delta += calcG(r.deviation) * (1.0 - calcE(g.rating, r.rating, r.deviation))
-	}
-	for _, r := range g.losses {
-		delta += calcG(r.deviation) * (0.0 - calcE(g.rating, r.rating, r.deviation))
-	}
-	for _, r := range g.draws {
-		delta += calcG(r.deviation) * (0.5 - calcE(g.rating, r.rating, r.deviation))
+	for _, r := range results {
+		delta += calcG(r.deviation) * (r.score - calcE(rating, r.rating, r.deviation))
 	}
 	delta *= variance
 
 	// Step 5. Determine the new value of the volatility.
-	new_volatility := 0.0
-	a := math.Log((g.volatility * g.volatility))
-	x := 0.0
-	x_new := a
-	for math.Abs(x-x_new) > 0.0000001 {
-		x = x_new
-		d := (g.deviation * g.deviation) + variance + math.Exp(x)
-		h1 := -(x-a)/(kDVOL*kDVOL) - 0.5*math.Exp(x)/d + 0.5*math.Exp(x)*(delta/d)*(delta/d)
-		h2 := -1.0/(kDVOL*kDVOL) - 0.5*math.Exp(x)*((g.deviation*g.deviation)+variance)/(d*d) + 0.5*(delta*delta)*math.Exp(x)*((g.deviation*g.deviation)+variance-math.Exp(x))/(d*d*d)
-		x_new = x - h1/h2
-	}
-	new_volatility = math.Exp(x_new / 2.0)
+	new_volatility := solveVolatility(cfg, deviation, volatility, variance, delta)
 
 	// Step 6. Update the rating deviation to the new pre-rating period value.
-	pre_deviation := math.Sqrt((g.deviation * g.deviation) + (new_volatility * new_volatility))
+	pre_deviation := math.Sqrt((deviation * deviation) + (new_volatility * new_volatility))
 
 	// Step 7. Update the rating and RD to the new values.
 	new_deviation := 1.0 / (math.Sqrt(1.0/(pre_deviation*pre_deviation) + 1.0/variance))
 	new_rating := 0.0
+	for _, r := range results {
+		new_rating += calcG(r.deviation) * (r.score - calcE(rating, r.rating, r.deviation))
+	}
+	new_rating = new_rating*(new_deviation*new_deviation) + rating
+
+	return new_rating, new_deviation, new_volatility
+}
+
+// Update rating based on current results list, and clear results.
+func (g *Glicko2) Update() {
+
+	// Note that if a player does not compete during the rating period, then
+	// only Step 6 applies.
+	if len(g.wins)+len(g.losses)+len(g.draws) == 0 {
+		g.deviation = math.Sqrt((g.deviation * g.deviation) + (g.volatility * g.volatility))
+		return
+	}
+
+	results := make([]periodResult, 0, len(g.wins)+len(g.losses)+len(g.draws))
 	for _, r := range g.wins {
-		new_rating += calcG(r.deviation) * (1.0 - calcE(g.rating, r.rating, r.deviation))
+		results = append(results, periodResult{r.rating, r.deviation, 1.0})
 	}
 	for _, r := range g.losses {
-		new_rating += calcG(r.deviation) * (0.0 - calcE(g.rating, r.rating, r.deviation))
+		results = append(results, periodResult{r.rating, r.deviation, 0.0})
 	}
 	for _, r := range g.draws {
-		new_rating += calcG(r.deviation) * (0.5 - calcE(g.rating, r.rating, r.deviation))
+		results = append(results, periodResult{r.rating, r.deviation, 0.5})
 	}
-	new_rating = new_rating * (new_deviation * new_deviation)
-	new_rating += g.rating
 
-	// Step 8. Convert ratings and RD's back to original scale.
-	g.deviation = new_deviation
-	g.volatility = new_volatility
-	g.rating = new_rating
+	g.rating, g.deviation, g.volatility = computeUpdate(g.cfg(), g.rating, g.deviation, g.volatility, results)
 
 	// wipe our result lists
 	g.ClearResults()
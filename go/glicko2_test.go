@@ -1,8 +1,8 @@
 package glicko2
 
 import (
-	"testing"
 	"math"
+	"testing"
 )
 
 func inRange(want float64, have float64, delta float64) bool {
@@ -11,7 +11,7 @@ func inRange(want float64, have float64, delta float64) bool {
 
 func TestGlicko2(t *testing.T) {
 	a := NewGlicko2(1500.0, 200.0, 0.06)
-	b := NewGlicko2(1400.0,  30.0, 0.06)
+	b := NewGlicko2(1400.0, 30.0, 0.06)
 	c := NewGlicko2(1550.0, 100.0, 0.06)
 	d := NewGlicko2(1700.0, 300.0, 0.06)
 
@@ -28,20 +28,30 @@ func TestGlicko2(t *testing.T) {
 
 	a.Update()
 
-	if !inRange(a.Rating(),1464.05,0.01) {
+	if !inRange(a.Rating(), 1464.05, 0.01) {
 		t.Error()
 	}
-	if !inRange(a.Deviation(),151.516,0.01) {
+	if !inRange(a.Deviation(), 151.516, 0.01) {
 		t.Error()
 	}
 
 	a.Update()
 
-	if !inRange(a.Rating(),1464.05,0.01) {
+	if !inRange(a.Rating(), 1464.05, 0.01) {
 		t.Error()
 	}
-	if !inRange(a.Deviation(),151.875,0.01) {
+	if !inRange(a.Deviation(), 151.875, 0.01) {
 		t.Error()
 	}
 
 }
+
+// A zero-value Glicko2 (e.g. embedded in a struct, or created via
+// make([]Glicko2, n) rather than a New* constructor) must still work,
+// defaulting its Config lazily instead of panicking on a nil g.config.
+func TestZeroValueUpdate(t *testing.T) {
+	var g Glicko2
+	b := NewGlicko2(1400.0, 30.0, 0.06)
+	g.AddWin(b)
+	g.Update()
+}
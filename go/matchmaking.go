@@ -0,0 +1,91 @@
+package glicko2
+
+import (
+	"math"
+	"sort"
+)
+
+// MatchCandidate pairs an opponent with the win probability Matchmake
+// computed for it against the queried candidate.
+type MatchCandidate struct {
+	Opponent *Glicko2
+	ProbWin  float64
+}
+
+// Matchmake returns the k opponents from pool whose predicted win
+// probability against candidate is closest to 0.5 - the fairness
+// criterion a skill-based matchmaker aims for when pairing players of
+// uneven skill - ordered from fairest to least fair. If len(pool) < k,
+// every pool member is returned.
+func Matchmake(pool []*Glicko2, candidate *Glicko2, k int) []MatchCandidate {
+	candidates := make([]MatchCandidate, 0, len(pool))
+	for _, opponent := range pool {
+		candidates = append(candidates, MatchCandidate{opponent, candidate.ProbWin(opponent)})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return math.Abs(candidates[i].ProbWin-0.5) < math.Abs(candidates[j].ProbWin-0.5)
+	})
+
+	if k < 0 {
+		k = 0
+	}
+	if k < len(candidates) {
+		candidates = candidates[:k]
+	}
+	return candidates
+}
+
+// ExpectedScoreMatrix returns an n-by-n matrix where entry [i][j] is
+// players[i]'s predicted win probability against players[j]. The diagonal
+// is always 0.5.
+func ExpectedScoreMatrix(players []*Glicko2) [][]float64 {
+	matrix := make([][]float64, len(players))
+	for i, p := range players {
+		matrix[i] = make([]float64, len(players))
+		for j, opponent := range players {
+			if i == j {
+				matrix[i][j] = 0.5
+				continue
+			}
+			matrix[i][j] = p.ProbWin(opponent)
+		}
+	}
+	return matrix
+}
+
+// ConfidenceInterval returns [rating-z*RD, rating+z*RD] on the Glicko
+// scale: a conservative range for g's true skill, analogous to TrueSkill's
+// mu-3*sigma. Typical values for z are 1.96 (95%) or 3 (near-certain).
+func (g *Glicko2) ConfidenceInterval(z float64) (lo float64, hi float64) {
+	return g.Rating() - z*g.Deviation(), g.Rating() + z*g.Deviation()
+}
+
+// Quantile returns the Glicko-scale rating below which a fraction p of
+// players falls; p must be in [0, 1]. Useful for leaderboard percentile
+// cutoffs, e.g. Quantile(players, 0.9) for the top-10% cutoff.
+func Quantile(players []*Glicko2, p float64) float64 {
+	if len(players) == 0 {
+		return 0
+	}
+	if p < 0 {
+		p = 0
+	} else if p > 1 {
+		p = 1
+	}
+
+	ratings := make([]float64, len(players))
+	for i, player := range players {
+		ratings[i] = player.Rating()
+	}
+	sort.Float64s(ratings)
+
+	idx := p * float64(len(ratings)-1)
+	lo := int(math.Floor(idx))
+	hi := int(math.Ceil(idx))
+	if lo == hi {
+		return ratings[lo]
+	}
+	frac := idx - float64(lo)
+	return ratings[lo]*(1-frac) + ratings[hi]*frac
+}
@@ -0,0 +1,68 @@
+package glicko2
+
+import (
+	"testing"
+)
+
+func TestMatchmake(t *testing.T) {
+	candidate := NewGlicko2(1500.0, 50.0, 0.06)
+	pool := []*Glicko2{
+		NewGlicko2(1000.0, 50.0, 0.06),
+		NewGlicko2(1490.0, 50.0, 0.06),
+		NewGlicko2(2000.0, 50.0, 0.06),
+	}
+
+	matches := Matchmake(pool, candidate, 2)
+	if len(matches) != 2 {
+		t.Error()
+	}
+	if matches[0].Opponent != pool[1] {
+		t.Error()
+	}
+}
+
+func TestExpectedScoreMatrix(t *testing.T) {
+	players := []*Glicko2{
+		NewGlicko2(1500.0, 50.0, 0.06),
+		NewGlicko2(1600.0, 50.0, 0.06),
+	}
+
+	matrix := ExpectedScoreMatrix(players)
+
+	if !inRange(matrix[0][0], 0.5, 0.0001) {
+		t.Error()
+	}
+	if !inRange(matrix[0][1]+matrix[1][0], 1.0, 0.0001) {
+		t.Error()
+	}
+}
+
+func TestConfidenceInterval(t *testing.T) {
+	g := NewGlicko2(1500.0, 50.0, 0.06)
+
+	lo, hi := g.ConfidenceInterval(2.0)
+	if !inRange(lo, 1400.0, 0.0001) {
+		t.Error()
+	}
+	if !inRange(hi, 1600.0, 0.0001) {
+		t.Error()
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	players := []*Glicko2{
+		NewGlicko2(1000.0, 50.0, 0.06),
+		NewGlicko2(1500.0, 50.0, 0.06),
+		NewGlicko2(2000.0, 50.0, 0.06),
+	}
+
+	if !inRange(Quantile(players, 0.5), 1500.0, 0.0001) {
+		t.Error()
+	}
+	if !inRange(Quantile(players, 0.0), 1000.0, 0.0001) {
+		t.Error()
+	}
+	if !inRange(Quantile(players, 1.0), 2000.0, 0.0001) {
+		t.Error()
+	}
+}
@@ -0,0 +1,118 @@
+package glicko2
+
+import (
+	"math"
+	"sync"
+)
+
+// RatingPeriod batches many players' results together so that, unlike
+// AddWin/AddLoss/AddDraw + Update(), every player's update is computed
+// against a single snapshot of pre-period ratings taken once per period,
+// rather than a duplicate() of each opponent taken at the moment the
+// result was recorded.  Add matches with AddMatch, register players with
+// no games via AddNoOp, then call Commit (or CommitParallel) once to write
+// every player's new rating/deviation/volatility back atomically.
+type RatingPeriod struct {
+	players  []*Glicko2
+	snapshot map[*Glicko2]periodResult0
+	results  map[*Glicko2][]periodResult
+}
+
+// periodResult0 is a player's own pre-period (rating, deviation, volatility),
+// captured once the first time the player appears in the period.
+type periodResult0 struct {
+	rating     float64
+	deviation  float64
+	volatility float64
+}
+
+// NewRatingPeriod creates an empty RatingPeriod.
+func NewRatingPeriod() *RatingPeriod {
+	return &RatingPeriod{
+		snapshot: make(map[*Glicko2]periodResult0),
+		results:  make(map[*Glicko2][]periodResult),
+	}
+}
+
+// register snapshots a player's pre-period state the first time it's seen
+// in this period, and adds it to the commit list.
+func (p *RatingPeriod) register(g *Glicko2) {
+	if _, ok := p.snapshot[g]; ok {
+		return
+	}
+	p.players = append(p.players, g)
+	p.snapshot[g] = periodResult0{g.rating, g.deviation, g.volatility}
+}
+
+// AddMatch records a single result between a and b for this period.  score
+// is a's score against b: 1 for a win, 0 for a loss, 0.5 for a draw.  No
+// calculation is performed until Commit (or CommitParallel) is called.
+func (p *RatingPeriod) AddMatch(a *Glicko2, b *Glicko2, score float64) {
+	p.register(a)
+	p.register(b)
+	sa, sb := p.snapshot[a], p.snapshot[b]
+	p.results[a] = append(p.results[a], periodResult{sb.rating, sb.deviation, score})
+	p.results[b] = append(p.results[b], periodResult{sa.rating, sa.deviation, 1.0 - score})
+}
+
+// AddNoOp registers player as having sat out this rating period, so Commit
+// applies the Step 6 RD-only growth to it even though AddMatch was never
+// called on its behalf.
+func (p *RatingPeriod) AddNoOp(player *Glicko2) {
+	p.register(player)
+	if _, ok := p.results[player]; !ok {
+		p.results[player] = nil
+	}
+}
+
+// commitOne computes and writes back player's post-period state from its
+// period snapshot and recorded results.
+func (p *RatingPeriod) commitOne(player *Glicko2) {
+	snap := p.snapshot[player]
+	results := p.results[player]
+
+	if len(results) == 0 {
+		player.deviation = math.Sqrt((snap.deviation * snap.deviation) + (snap.volatility * snap.volatility))
+		return
+	}
+
+	player.rating, player.deviation, player.volatility = computeUpdate(player.cfg(), snap.rating, snap.deviation, snap.volatility, results)
+}
+
+// Commit computes every registered player's post-period rating, deviation,
+// and volatility against the period's pre-period snapshot, then writes them
+// all back.  Any results recorded outside of this RatingPeriod (e.g. via
+// AddWin) are left untouched and still require a separate Update() call.
+func (p *RatingPeriod) Commit() {
+	for _, player := range p.players {
+		p.commitOne(player)
+	}
+}
+
+// CommitParallel behaves like Commit, but shards players across workers
+// goroutines.  Each player's update depends only on the period's snapshot,
+// so this is safe as long as the same *Glicko2 does not appear in two
+// different RatingPeriods being committed concurrently.
+func (p *RatingPeriod) CommitParallel(workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobs := make(chan *Glicko2, len(p.players))
+	for _, player := range p.players {
+		jobs <- player
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for player := range jobs {
+				p.commitOne(player)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,65 @@
+package glicko2
+
+import (
+	"testing"
+)
+
+func TestRatingPeriodMatchesSequentialUpdate(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+	b := NewGlicko2(1400.0, 30.0, 0.06)
+
+	a.AddWin(b)
+	a.Update()
+
+	a2 := NewGlicko2(1500.0, 200.0, 0.06)
+	b2 := NewGlicko2(1400.0, 30.0, 0.06)
+
+	period := NewRatingPeriod()
+	period.AddMatch(a2, b2, 1.0)
+	period.Commit()
+
+	if !inRange(a.Rating(), a2.Rating(), 0.0001) {
+		t.Error()
+	}
+	if !inRange(a.Deviation(), a2.Deviation(), 0.0001) {
+		t.Error()
+	}
+	if !inRange(a.Volatility(), a2.Volatility(), 0.0001) {
+		t.Error()
+	}
+}
+
+func TestRatingPeriodNoOp(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+
+	period := NewRatingPeriod()
+	period.AddNoOp(a)
+	period.Commit()
+
+	if a.Deviation() <= 200.0 {
+		t.Error()
+	}
+}
+
+func TestRatingPeriodCommitParallel(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+	b := NewGlicko2(1400.0, 30.0, 0.06)
+
+	a2 := NewGlicko2(1500.0, 200.0, 0.06)
+	b2 := NewGlicko2(1400.0, 30.0, 0.06)
+
+	period := NewRatingPeriod()
+	period.AddMatch(a, b, 1.0)
+	period.Commit()
+
+	periodParallel := NewRatingPeriod()
+	periodParallel.AddMatch(a2, b2, 1.0)
+	periodParallel.CommitParallel(4)
+
+	if !inRange(a.Rating(), a2.Rating(), 0.0001) {
+		t.Error()
+	}
+	if !inRange(a.Deviation(), a2.Deviation(), 0.0001) {
+		t.Error()
+	}
+}
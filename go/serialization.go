@@ -0,0 +1,139 @@
+package glicko2
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+)
+
+// ratingSchemaVersion tags the wire/storage format emitted by the Marshal*
+// methods below, so a future format change can be detected on Unmarshal.
+const ratingSchemaVersion = 1
+
+// ratingJSON is the on-the-wire JSON shape for a Glicko2: the Glicko-scale
+// rating/deviation used everywhere else in this package's public API, plus
+// the raw, unscaled volatility.
+type ratingJSON struct {
+	Version    int     `json:"version"`
+	Rating     float64 `json:"rating"`
+	Deviation  float64 `json:"deviation"`
+	Volatility float64 `json:"volatility"`
+}
+
+// Load creates a Glicko2 from a previously persisted Glicko-scale rating,
+// deviation, and volatility, e.g. one read back from a database row or
+// decoded independently of MarshalJSON/MarshalBinary.
+func Load(rating float64, deviation float64, volatility float64) *Glicko2 {
+	return NewGlicko2(rating, deviation, volatility)
+}
+
+// Mu returns the internal Glicko-2 scale rating (what Glickman's paper
+// calls mu). Rating() returns the same value converted to the Glicko scale.
+func (g *Glicko2) Mu() float64 {
+	return g.rating
+}
+
+// Phi returns the internal Glicko-2 scale deviation (what Glickman's paper
+// calls phi). Deviation() returns the same value converted to the Glicko
+// scale.
+func (g *Glicko2) Phi() float64 {
+	return g.deviation
+}
+
+// MarshalJSON implements json.Marshaler, emitting the Glicko-scale rating
+// and deviation, the raw volatility, and a schema version tag.
+func (g *Glicko2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ratingJSON{
+		Version:    ratingSchemaVersion,
+		Rating:     g.Rating(),
+		Deviation:  g.Deviation(),
+		Volatility: g.Volatility(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *Glicko2) UnmarshalJSON(data []byte) error {
+	var r ratingJSON
+	if err := json.Unmarshal(data, &r); err != nil {
+		return err
+	}
+	if r.Version != ratingSchemaVersion {
+		return fmt.Errorf("glicko2: unsupported schema version %d", r.Version)
+	}
+	g.cfg()
+	g.SetRating(r.Rating)
+	g.SetDeviation(r.Deviation)
+	g.SetVolatility(r.Volatility)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler: a schema version byte
+// followed by the big-endian Glicko-scale rating, deviation, and raw
+// volatility, each a float64.
+func (g *Glicko2) MarshalBinary() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, binary.BigEndian, uint8(ratingSchemaVersion)); err != nil {
+		return nil, err
+	}
+	for _, v := range []float64{g.Rating(), g.Deviation(), g.Volatility()} {
+		if err := binary.Write(buf, binary.BigEndian, v); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (g *Glicko2) UnmarshalBinary(data []byte) error {
+	buf := bytes.NewReader(data)
+
+	var version uint8
+	if err := binary.Read(buf, binary.BigEndian, &version); err != nil {
+		return err
+	}
+	if version != ratingSchemaVersion {
+		return fmt.Errorf("glicko2: unsupported schema version %d", version)
+	}
+
+	var rating, deviation, volatility float64
+	if err := binary.Read(buf, binary.BigEndian, &rating); err != nil {
+		return err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &deviation); err != nil {
+		return err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &volatility); err != nil {
+		return err
+	}
+
+	g.cfg()
+	g.SetRating(rating)
+	g.SetDeviation(deviation)
+	g.SetVolatility(volatility)
+	return nil
+}
+
+// Value implements driver.Valuer, storing a rating as its JSON
+// representation so it round-trips through any SQL TEXT/JSON column.
+func (g *Glicko2) Value() (driver.Value, error) {
+	b, err := g.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner, accepting the string or []byte JSON
+// representation produced by Value.
+func (g *Glicko2) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return g.UnmarshalJSON([]byte(v))
+	case []byte:
+		return g.UnmarshalJSON(v)
+	default:
+		return fmt.Errorf("glicko2: cannot scan %T into Glicko2", src)
+	}
+}
@@ -0,0 +1,82 @@
+package glicko2
+
+import (
+	"testing"
+)
+
+func TestSerializationRoundTrip(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+	b := NewGlicko2(1400.0, 30.0, 0.06)
+	c := NewGlicko2(1550.0, 100.0, 0.06)
+	d := NewGlicko2(1700.0, 300.0, 0.06)
+
+	a.AddWin(b)
+	a.AddLoss(c)
+	a.AddLoss(d)
+	a.Update()
+
+	jsonBytes, err := a.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromJSON := Load(0, 0, 0)
+	if err := fromJSON.UnmarshalJSON(jsonBytes); err != nil {
+		t.Fatal(err)
+	}
+
+	binBytes, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromBinary := Load(0, 0, 0)
+	if err := fromBinary.UnmarshalBinary(binBytes); err != nil {
+		t.Fatal(err)
+	}
+
+	if fromJSON.Rating() != a.Rating() || fromJSON.Deviation() != a.Deviation() || fromJSON.Volatility() != a.Volatility() {
+		t.Error()
+	}
+	if fromBinary.Rating() != a.Rating() || fromBinary.Deviation() != a.Deviation() || fromBinary.Volatility() != a.Volatility() {
+		t.Error()
+	}
+}
+
+func TestUnmarshalPreservesCustomConfig(t *testing.T) {
+	g := NewWithConfig(&Config{
+		Tau:                  0.9,
+		ConvergenceTolerance: 0.0000001,
+		DefaultRating:        1500.0,
+		DefaultDeviation:     350.0,
+		DefaultVolatility:    0.06,
+	})
+
+	data, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if g.config.Tau != 0.9 {
+		t.Error()
+	}
+}
+
+func TestSQLValueScan(t *testing.T) {
+	a := NewGlicko2(1500.0, 200.0, 0.06)
+
+	value, err := a.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scanned := Load(0, 0, 0)
+	if err := scanned.Scan(value); err != nil {
+		t.Fatal(err)
+	}
+
+	if scanned.Rating() != a.Rating() || scanned.Deviation() != a.Deviation() || scanned.Volatility() != a.Volatility() {
+		t.Error()
+	}
+}